@@ -0,0 +1,51 @@
+package main
+
+import (
+	"image"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+type Animation struct {
+	Sheet         *ebiten.Image
+	FrameWidth    int
+	FrameHeight   int
+	FrameCount    int
+	Row           int
+	TicksPerFrame int
+
+	frame int
+	ticks int
+}
+
+func NewAnimation(sheet *ebiten.Image, frameWidth, frameHeight, frameCount, row, ticksPerFrame int) *Animation {
+	return &Animation{
+		Sheet:         sheet,
+		FrameWidth:    frameWidth,
+		FrameHeight:   frameHeight,
+		FrameCount:    frameCount,
+		Row:           row,
+		TicksPerFrame: ticksPerFrame,
+	}
+}
+
+func (a *Animation) Update() {
+	a.ticks++
+	if a.ticks >= a.TicksPerFrame {
+		a.ticks = 0
+		a.frame = (a.frame + 1) % a.FrameCount
+	}
+}
+
+func (a *Animation) Reset() {
+	a.frame = 0
+	a.ticks = 0
+}
+
+func (a *Animation) Frame() *ebiten.Image {
+	x0 := a.frame * a.FrameWidth
+	y0 := a.Row * a.FrameHeight
+
+	rect := image.Rect(x0, y0, x0+a.FrameWidth, y0+a.FrameHeight)
+	return a.Sheet.SubImage(rect).(*ebiten.Image)
+}
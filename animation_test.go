@@ -0,0 +1,44 @@
+package main
+
+import (
+	"image"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+func TestAnimationUpdateStepsFrameEveryTicksPerFrame(t *testing.T) {
+	a := NewAnimation(ebiten.NewImage(12, 8), 4, 4, 3, 0, 2)
+
+	for _, want := range []int{0, 0, 1, 1, 2, 2, 0, 0} {
+		if a.frame != want {
+			t.Fatalf("frame = %d, want %d", a.frame, want)
+		}
+		a.Update()
+	}
+}
+
+func TestAnimationResetRestartsAtFirstFrame(t *testing.T) {
+	a := NewAnimation(ebiten.NewImage(12, 8), 4, 4, 3, 0, 2)
+
+	a.Update()
+	a.Update()
+	a.Reset()
+
+	if a.frame != 0 {
+		t.Fatalf("frame after Reset = %d, want 0", a.frame)
+	}
+}
+
+func TestAnimationFrameReturnsSubImageForRowAndFrame(t *testing.T) {
+	a := NewAnimation(ebiten.NewImage(12, 8), 4, 4, 3, 1, 1)
+
+	a.Update()
+	a.Update()
+
+	got := a.Frame().Bounds()
+	want := image.Rect(8, 4, 12, 8)
+	if got != want {
+		t.Fatalf("Frame().Bounds() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+type BackgroundLayer struct {
+	Img     *ebiten.Image
+	ScrollX float64 // pixels per tick
+	ScrollY float64 // pixels per tick
+	Tile    bool    // repeat the image across the screen instead of drawing once
+
+	offsetX float64
+	offsetY float64
+}
+
+type Background struct {
+	Layers []BackgroundLayer
+}
+
+func NewBackground(layers ...BackgroundLayer) *Background {
+	return &Background{Layers: layers}
+}
+
+func (b *Background) Update() {
+	for i := range b.Layers {
+		l := &b.Layers[i]
+		l.offsetX += l.ScrollX
+		l.offsetY += l.ScrollY
+	}
+}
+
+func (b *Background) Draw(screen *ebiten.Image) {
+	for _, l := range b.Layers {
+		drawBackgroundLayer(screen, l)
+	}
+}
+
+func drawBackgroundLayer(screen *ebiten.Image, l BackgroundLayer) {
+	if l.Img == nil {
+		return
+	}
+
+	bounds := l.Img.Bounds()
+	w, h := float64(bounds.Dx()), float64(bounds.Dy())
+	if w == 0 || h == 0 {
+		return
+	}
+
+	if !l.Tile {
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Translate(-wrap(l.offsetX, w), -wrap(l.offsetY, h))
+		screen.DrawImage(l.Img, op)
+		return
+	}
+
+	startX := -wrap(l.offsetX, w)
+	startY := -wrap(l.offsetY, h)
+
+	for y := startY; y < screenHeight; y += h {
+		for x := startX; x < screenWidth; x += w {
+			op := &ebiten.DrawImageOptions{}
+			op.GeoM.Translate(x, y)
+			screen.DrawImage(l.Img, op)
+		}
+	}
+}
+
+func wrap(v, m float64) float64 {
+	v = math.Mod(v, m)
+	if v < 0 {
+		v += m
+	}
+	return v
+}
+
+func newDefaultBackground() *Background {
+	return NewBackground(
+		BackgroundLayer{
+			Img: mustLoadImage("assets/images/bg/sky.png"),
+		},
+		BackgroundLayer{
+			Img:     mustLoadImage("assets/images/bg/hills.png"),
+			ScrollX: 0.2,
+			Tile:    true,
+		},
+		BackgroundLayer{
+			Img:     mustLoadImage("assets/images/bg/clouds.png"),
+			ScrollX: 0.6,
+			Tile:    true,
+		},
+	)
+}
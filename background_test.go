@@ -0,0 +1,39 @@
+package main
+
+import "testing"
+
+func TestWrap(t *testing.T) {
+	cases := []struct {
+		v, m, want float64
+	}{
+		{0, 100, 0},
+		{50, 100, 50},
+		{150, 100, 50},
+		{-10, 100, 90},
+		{-150, 100, 50},
+		{100, 100, 0},
+	}
+
+	for _, c := range cases {
+		if got := wrap(c.v, c.m); got != c.want {
+			t.Errorf("wrap(%v, %v) = %v, want %v", c.v, c.m, got, c.want)
+		}
+	}
+}
+
+func TestBackgroundUpdateAccumulatesPerLayerOffset(t *testing.T) {
+	b := NewBackground(
+		BackgroundLayer{ScrollX: 1, ScrollY: 2},
+		BackgroundLayer{ScrollX: 0.5},
+	)
+
+	b.Update()
+	b.Update()
+
+	if b.Layers[0].offsetX != 2 || b.Layers[0].offsetY != 4 {
+		t.Fatalf("layer 0 offset = (%v, %v), want (2, 4)", b.Layers[0].offsetX, b.Layers[0].offsetY)
+	}
+	if b.Layers[1].offsetX != 1 {
+		t.Fatalf("layer 1 offsetX = %v, want 1", b.Layers[1].offsetX)
+	}
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+func TestPlayerDamageFloorsAtZero(t *testing.T) {
+	p := &Player{Lives: 1}
+
+	p.Damage()
+	if p.Lives != 0 {
+		t.Fatalf("Lives = %d, want 0", p.Lives)
+	}
+
+	p.Damage()
+	if p.Lives != 0 {
+		t.Fatalf("Lives = %d after damaging at 0, want 0 (not wrapped)", p.Lives)
+	}
+}
+
+func TestFoodGravityClampsToMax(t *testing.T) {
+	g := &Game{score: 0}
+	if got := g.foodGravity(); got != baseGravity {
+		t.Fatalf("foodGravity() at score 0 = %v, want %v", got, baseGravity)
+	}
+
+	g.score = 10000
+	if got := g.foodGravity(); got != maxGravity {
+		t.Fatalf("foodGravity() at high score = %v, want clamped %v", got, maxGravity)
+	}
+}
+
+func TestBombChanceClampsToMax(t *testing.T) {
+	g := &Game{score: 0}
+	if got := g.bombChance(); got != baseBombChance {
+		t.Fatalf("bombChance() at score 0 = %v, want %v", got, baseBombChance)
+	}
+
+	g.score = 10000
+	if got := g.bombChance(); got != maxBombChance {
+		t.Fatalf("bombChance() at high score = %v, want clamped %v", got, maxBombChance)
+	}
+}
+
+func TestSpawnIntervalTicksClampsToMin(t *testing.T) {
+	g := &Game{score: 10000}
+	minTicks := int(minSpawnInterval.Milliseconds()) * ebiten.TPS() / 1000
+	if got := g.spawnIntervalTicks(); got != minTicks {
+		t.Fatalf("spawnIntervalTicks() at high score = %v, want clamped %v", got, minTicks)
+	}
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+const gamepadDeadzone = 0.2
+
+type InputSource interface {
+	Axis() float64
+}
+
+type keyboardInput struct{}
+
+func (keyboardInput) Axis() float64 {
+	axis := 0.0
+	if ebiten.IsKeyPressed(ebiten.KeyArrowLeft) {
+		axis -= 1
+	}
+	if ebiten.IsKeyPressed(ebiten.KeyArrowRight) {
+		axis += 1
+	}
+	return axis
+}
+
+type gamepadInput struct{}
+
+func (gamepadInput) Axis() float64 {
+	axis := 0.0
+
+	for _, id := range ebiten.AppendGamepadIDs(nil) {
+		if !ebiten.IsStandardGamepadLayoutAvailable(id) {
+			continue
+		}
+
+		if v := ebiten.StandardGamepadAxisValue(id, ebiten.StandardGamepadAxisLeftStickHorizontal); v < -gamepadDeadzone || v > gamepadDeadzone {
+			axis = v
+		}
+
+		if ebiten.IsStandardGamepadButtonPressed(id, ebiten.StandardGamepadButtonLeftLeft) {
+			axis = -1
+		}
+		if ebiten.IsStandardGamepadButtonPressed(id, ebiten.StandardGamepadButtonLeftRight) {
+			axis = 1
+		}
+	}
+
+	return axis
+}
+
+type touchInput struct{}
+
+func (touchInput) Axis() float64 {
+	axis := 0.0
+
+	for _, id := range ebiten.AppendTouchIDs(nil) {
+		x, _ := ebiten.TouchPosition(id)
+		lx, _ := screenToLogical(float64(x), 0)
+		if lx < screenWidth/2 {
+			axis = -1
+		} else {
+			axis = 1
+		}
+	}
+
+	return axis
+}
+
+var inputSources = []InputSource{
+	keyboardInput{},
+	gamepadInput{},
+	touchInput{},
+}
+
+func aggregateAxis() float64 {
+	axis := 0.0
+	for _, s := range inputSources {
+		if v := s.Axis(); v != 0 {
+			axis = v
+		}
+	}
+	return axis
+}
@@ -0,0 +1,32 @@
+package main
+
+import "testing"
+
+type fakeInput float64
+
+func (f fakeInput) Axis() float64 { return float64(f) }
+
+func TestAggregateAxisLaterSourceWins(t *testing.T) {
+	saved := inputSources
+	defer func() { inputSources = saved }()
+
+	inputSources = []InputSource{fakeInput(0), fakeInput(-1), fakeInput(0)}
+	if got := aggregateAxis(); got != -1 {
+		t.Fatalf("aggregateAxis() = %v, want -1", got)
+	}
+
+	inputSources = []InputSource{fakeInput(-1), fakeInput(1)}
+	if got := aggregateAxis(); got != 1 {
+		t.Fatalf("aggregateAxis() = %v, want 1", got)
+	}
+}
+
+func TestAggregateAxisAllIdle(t *testing.T) {
+	saved := inputSources
+	defer func() { inputSources = saved }()
+
+	inputSources = []InputSource{fakeInput(0), fakeInput(0)}
+	if got := aggregateAxis(); got != 0 {
+		t.Fatalf("aggregateAxis() = %v, want 0", got)
+	}
+}
@@ -0,0 +1,34 @@
+package main
+
+import "testing"
+
+func TestScreenToLogicalInvertsBlitTransform(t *testing.T) {
+	saved := blitTransform
+	defer func() { blitTransform = saved }()
+
+	blitTransform.scale = 2
+	blitTransform.offsetX = 100
+	blitTransform.offsetY = 40
+
+	x, y := screenToLogical(100, 40)
+	if x != 0 || y != 0 {
+		t.Fatalf("screenToLogical(100, 40) = (%v, %v), want (0, 0)", x, y)
+	}
+
+	x, y = screenToLogical(100+640, 40+480)
+	if x != screenWidth || y != screenHeight {
+		t.Fatalf("screenToLogical(bottom-right) = (%v, %v), want (%v, %v)", x, y, screenWidth, screenHeight)
+	}
+}
+
+func TestScreenToLogicalPassesThroughBeforeFirstDraw(t *testing.T) {
+	saved := blitTransform
+	defer func() { blitTransform = saved }()
+
+	blitTransform.scale = 0
+
+	x, y := screenToLogical(123, 45)
+	if x != 123 || y != 45 {
+		t.Fatalf("screenToLogical before first draw = (%v, %v), want passthrough (123, 45)", x, y)
+	}
+}
@@ -7,10 +7,13 @@ import (
 	"image"
 	"image/color"
 	_ "image/png"
+	"math"
 	"math/rand"
+	"sync"
 	"time"
 
 	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
 	"github.com/hajimehoshi/ebiten/v2/text/v2"
 )
 
@@ -133,46 +136,79 @@ type Sprite struct {
 	Position Vector
 }
 
+type PlayerState int
+
+const (
+	PlayerIdle PlayerState = iota
+	PlayerWalkLeft
+	PlayerWalkRight
+)
+
+// robo-sheet.png is laid out playerFrameCount frames wide, one row per
+// PlayerState in the order idle, walk-left, walk-right.
+const (
+	playerFrameWidth    = 32
+	playerFrameHeight   = 48
+	playerFrameCount    = 4
+	playerTicksPerFrame = 8
+)
+
 type Player struct {
 	*Sprite
 	Lives uint16
+
+	anims map[PlayerState]*Animation
+	state PlayerState
 }
 
 func initPlayer() *Player {
-	playerSprite := mustLoadImage("assets/images/robo.png")
-
-	bounds := playerSprite.Bounds()
-	halfW := float64(bounds.Dx()) / 2
+	sheet := mustLoadImage("assets/images/robo-sheet.png")
 
 	pos := Vector{
-		X: screenWidth/2 - halfW,
+		X: screenWidth/2 - playerFrameWidth/2,
 		Y: 420,
 	}
 
+	anims := map[PlayerState]*Animation{
+		PlayerIdle:      NewAnimation(sheet, playerFrameWidth, playerFrameHeight, playerFrameCount, int(PlayerIdle), playerTicksPerFrame),
+		PlayerWalkLeft:  NewAnimation(sheet, playerFrameWidth, playerFrameHeight, playerFrameCount, int(PlayerWalkLeft), playerTicksPerFrame),
+		PlayerWalkRight: NewAnimation(sheet, playerFrameWidth, playerFrameHeight, playerFrameCount, int(PlayerWalkRight), playerTicksPerFrame),
+	}
+
 	return &Player{
 		Sprite: &Sprite{
-			Img:      playerSprite,
+			Img:      anims[PlayerIdle].Frame(),
 			Position: pos,
 		},
 		Lives: 3,
+		anims: anims,
+		state: PlayerIdle,
 	}
 }
 
-func (p *Player) Update() {
+func (p *Player) Update(bounds Rect) {
 	speed := float64(600 / ebiten.TPS())
-	rightOffset := 25
+	rightOffset := 25.0
 
-	if p.Position.X > 0 {
-		if ebiten.IsKeyPressed(ebiten.KeyArrowLeft) {
-			p.Position.X -= speed
-		}
+	axis := aggregateAxis()
+
+	next := PlayerIdle
+
+	if axis < 0 && p.Position.X > bounds.X {
+		p.Position.X += axis * speed
+		next = PlayerWalkLeft
+	} else if axis > 0 && p.Position.X < bounds.MaxX()-playerFrameWidth-rightOffset {
+		p.Position.X += axis * speed
+		next = PlayerWalkRight
 	}
 
-	if p.Position.X < float64(screenWidth-p.Img.Bounds().Dx()-rightOffset) {
-		if ebiten.IsKeyPressed(ebiten.KeyArrowRight) {
-			p.Position.X += speed
-		}
+	if next != p.state {
+		p.state = next
+		p.anims[p.state].Reset()
 	}
+
+	p.anims[p.state].Update()
+	p.Img = p.anims[p.state].Frame()
 }
 
 func (p *Player) Draw(screen *ebiten.Image) {
@@ -202,21 +238,67 @@ func (p *Player) Collider() Rect {
 	)
 }
 
+func (p *Player) Damage() {
+	if p.Lives > 0 {
+		p.Lives--
+	}
+}
+
+type FoodKind int
+
+const (
+	FoodGood FoodKind = iota
+	FoodBomb
+)
+
+// Sheets are a single row of foodFrameCount tumble frames, loaded once and
+// shared across every spawned Food.
+const (
+	foodFrameWidth    = 32
+	foodFrameHeight   = 32
+	foodFrameCount    = 6
+	foodTicksPerFrame = 6
+)
+
+var (
+	appleSheet = mustLoadImage("assets/images/apple-sheet.png")
+	bombaSheet = mustLoadImage("assets/images/bomba-sheet.png")
+)
+
 type Food struct {
 	*Sprite
+	Kind    FoodKind
+	Gravity float64
+
+	anim *Animation
 }
 
-func newFood() *Food {
-	sprite := mustLoadImage("assets/images/bomba.png")
-	x := rand.Float64() * (screenWidth - float64(sprite.Bounds().Dx()))
-	y := -20.0
+var foodPool = sync.Pool{
+	New: func() any { return &Food{Sprite: &Sprite{}} },
+}
 
-	return &Food{
-		&Sprite{
-			Img:      sprite,
-			Position: Vector{X: x, Y: y},
-		},
+func newFood(kind FoodKind, gravity float64) *Food {
+	sheet := appleSheet
+	if kind == FoodBomb {
+		sheet = bombaSheet
 	}
+
+	f := foodPool.Get().(*Food)
+	f.Kind = kind
+	f.Gravity = gravity
+	f.anim = NewAnimation(sheet, foodFrameWidth, foodFrameHeight, foodFrameCount, 0, foodTicksPerFrame)
+	f.Position = Vector{
+		X: rand.Float64() * (screenWidth - float64(foodFrameWidth)),
+		Y: -20.0,
+	}
+	f.Img = f.anim.Frame()
+
+	return f
+}
+
+func releaseFood(f *Food) {
+	f.anim = nil
+	foodPool.Put(f)
 }
 
 func (f *Food) Draw(screen *ebiten.Image) {
@@ -236,8 +318,10 @@ func (f *Food) Draw(screen *ebiten.Image) {
 }
 
 func (f *Food) Update() {
-	gravity := 10.0
-	f.Position.Y += gravity
+	f.Position.Y += f.Gravity
+
+	f.anim.Update()
+	f.Img = f.anim.Frame()
 }
 
 func (f *Food) Collider() Rect {
@@ -251,59 +335,191 @@ func (f *Food) Collider() Rect {
 	)
 }
 
+type State int
+
+const (
+	StatePlaying State = iota
+	StateGameOver
+	StateTitle
+)
+
+const (
+	baseSpawnInterval = 1 * time.Second
+	minSpawnInterval  = 250 * time.Millisecond
+
+	baseGravity = 6.0
+	maxGravity  = 18.0
+
+	baseBombChance = 0.15
+	maxBombChance  = 0.45
+)
+
 type Game struct {
 	player         *Player
 	food           []*Food
 	foodSpawnTimer *Timer
 	score          uint16
+	background     *Background
+	state          State
+	sound          *SoundManager
+	grid           *Grid
+	canvas         *ebiten.Image
+}
+
+func (g *Game) Bounds() Rect {
+	return NewRect(0, 0, screenWidth, screenHeight)
 }
 
 func initGame() *Game {
 	g := &Game{
 		player:         initPlayer(),
-		foodSpawnTimer: NewTimer(1 * time.Second),
+		foodSpawnTimer: NewTimer(baseSpawnInterval),
 		score:          0,
+		background:     newDefaultBackground(),
+		state:          StatePlaying,
+		sound:          NewSoundManager(),
+		grid:           NewGrid(gridCellSize),
 	}
 	g.Layout(screenWidth, screenHeight)
+	g.sound.PlayMusic("assets/audio/music.ogg")
 	return g
 }
 
+func (g *Game) Reset() {
+	g.player = initPlayer()
+	g.food = nil
+	g.score = 0
+	g.foodSpawnTimer = NewTimer(baseSpawnInterval)
+	g.state = StatePlaying
+	g.sound.PlayMusic("assets/audio/music.ogg")
+}
+
+func (g *Game) foodGravity() float64 {
+	gravity := baseGravity + float64(g.score)*0.05
+	if gravity > maxGravity {
+		gravity = maxGravity
+	}
+	return gravity
+}
+
+func (g *Game) bombChance() float64 {
+	chance := baseBombChance + float64(g.score)*0.002
+	if chance > maxBombChance {
+		chance = maxBombChance
+	}
+	return chance
+}
+
+func (g *Game) spawnIntervalTicks() int {
+	interval := baseSpawnInterval - time.Duration(g.score)*15*time.Millisecond
+	if interval < minSpawnInterval {
+		interval = minSpawnInterval
+	}
+	return int(interval.Milliseconds()) * ebiten.TPS() / 1000
+}
+
+func (g *Game) spawnFood() {
+	kind := FoodGood
+	if rand.Float64() < g.bombChance() {
+		kind = FoodBomb
+	}
+
+	g.food = append(g.food, newFood(kind, g.foodGravity()))
+	g.foodSpawnTimer.targetTicks = g.spawnIntervalTicks()
+}
+
 func (g *Game) Update() error {
-	g.player.Update()
+	if inpututil.IsKeyJustPressed(ebiten.KeyM) {
+		g.sound.ToggleMute()
+	}
+
+	if g.state == StateGameOver {
+		if inpututil.IsKeyJustPressed(ebiten.KeyR) {
+			g.Reset()
+		}
+		return nil
+	}
+
+	g.background.Update()
+	g.player.Update(g.Bounds())
 
 	g.foodSpawnTimer.Update()
 	if g.foodSpawnTimer.IsReady() {
 		g.foodSpawnTimer.Reset()
+		g.spawnFood()
+	}
 
-		f := newFood()
-		g.food = append(g.food, f)
+	g.grid.Clear()
+	for _, f := range g.food {
+		g.grid.Insert(f)
 	}
 
-	for i, f := range g.food {
-		if f.Collider().Intersects(g.player.Collider()) {
-			g.food = append(g.food[:i], g.food[i+1:]...)
+	remove := make(map[*Food]bool)
+	playerRect := g.player.Collider()
+	for _, f := range g.grid.Query(playerRect) {
+		if remove[f] || !f.Collider().Intersects(playerRect) {
+			continue
+		}
+		remove[f] = true
+
+		if f.Kind == FoodBomb {
+			g.player.Damage()
+			g.sound.Play("bomb")
+		} else {
 			g.score++
+			g.sound.Play("catch")
+		}
+	}
+
+	for _, f := range g.food {
+		if f.Position.Y > screenHeight {
+			remove[f] = true
 		}
 	}
 
+	if len(remove) > 0 {
+		alive := g.food[:0]
+		for _, f := range g.food {
+			if remove[f] {
+				releaseFood(f)
+				continue
+			}
+			alive = append(alive, f)
+		}
+		g.food = alive
+	}
+
 	for _, f := range g.food {
 		f.Update()
 	}
+
+	if g.player.Lives == 0 {
+		g.state = StateGameOver
+		g.sound.StopMusic()
+		g.sound.Play("game-over")
+	}
+
 	return nil
 }
 
 func (g *Game) Draw(screen *ebiten.Image) {
-	screen.Fill(color.RGBA{100, 149, 237, 255})
+	if g.canvas == nil {
+		g.canvas = ebiten.NewImage(screenWidth, screenHeight)
+	}
+	canvas := g.canvas
+	canvas.Clear()
+
+	g.background.Draw(canvas)
 	for _, f := range g.food {
-		f.Draw(screen)
+		f.Draw(canvas)
 	}
-	g.player.Draw(screen)
+	g.player.Draw(canvas)
 
 	to := &text.DrawOptions{}
 	to.GeoM.Translate(screenWidth/2-100, 20)
 	to.ColorScale.ScaleWithColor(color.White)
 
-	text.Draw(screen, fmt.Sprintf("%06d", g.score), scoreFont, to)
+	text.Draw(canvas, fmt.Sprintf("%06d", g.score), scoreFont, to)
 
 	op := &ebiten.DrawImageOptions{}
 	op.GeoM.Scale(0.03, 0.03)
@@ -314,14 +530,64 @@ func (g *Game) Draw(screen *ebiten.Image) {
 	ho.GeoM.Translate(50, 17)
 	ho.ColorScale.ScaleWithColor(color.RGBA{255, 0, 0, 255})
 
-	text.Draw(screen, fmt.Sprintf("0%d", g.player.Lives), healthFont, ho)
-	screen.DrawImage(healthIcon.Img, op)
+	text.Draw(canvas, fmt.Sprintf("0%d", g.player.Lives), healthFont, ho)
+	canvas.DrawImage(healthIcon.Img, op)
+
+	if g.state == StateGameOver {
+		oo := &text.DrawOptions{}
+		oo.GeoM.Translate(screenWidth/2-220, screenHeight/2-20)
+		oo.ColorScale.ScaleWithColor(color.White)
+
+		msg := fmt.Sprintf("Game Over - Score: %06d - Press R", g.score)
+		text.Draw(canvas, msg, healthFont, oo)
+	}
+
+	blitLetterboxed(screen, canvas)
+}
+
+// blitTransform is the scale/offset blitLetterboxed last drew the logical
+// canvas with; screenToLogical inverts it so touch/cursor input (reported
+// in screen space) can be compared against logical-space positions.
+var blitTransform struct {
+	scale   float64
+	offsetX float64
+	offsetY float64
+}
+
+func blitLetterboxed(screen, canvas *ebiten.Image) {
+	sw, sh := screen.Bounds().Dx(), screen.Bounds().Dy()
+	scale := math.Min(float64(sw)/screenWidth, float64(sh)/screenHeight)
+	offsetX := (float64(sw) - screenWidth*scale) / 2
+	offsetY := (float64(sh) - screenHeight*scale) / 2
+
+	blitTransform.scale = scale
+	blitTransform.offsetX = offsetX
+	blitTransform.offsetY = offsetY
+
+	op := &ebiten.DrawImageOptions{}
+	op.Filter = ebiten.FilterLinear
+	op.GeoM.Scale(scale, scale)
+	op.GeoM.Translate(offsetX, offsetY)
+
+	screen.DrawImage(canvas, op)
+}
+
+func screenToLogical(x, y float64) (float64, float64) {
+	if blitTransform.scale == 0 {
+		return x, y
+	}
+	return (x - blitTransform.offsetX) / blitTransform.scale, (y - blitTransform.offsetY) / blitTransform.scale
 }
 
 func (g *Game) Layout(outsideWidth, outsideHeight int) (screenWidth, screenHeight int) {
 	return outsideWidth, outsideHeight
 }
 
+func (g *Game) LayoutF(outsideWidth, outsideHeight float64) (float64, float64) {
+	scale := ebiten.Monitor().DeviceScaleFactor()
+	return outsideWidth * scale, outsideHeight * scale
+}
+
 func main() {
 	g := initGame()
 
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/hajimehoshi/ebiten/v2/audio"
+	"github.com/hajimehoshi/ebiten/v2/audio/vorbis"
+	"github.com/hajimehoshi/ebiten/v2/audio/wav"
+)
+
+const sampleRate = 44100
+
+var audioContext = audio.NewContext(sampleRate)
+
+type SoundManager struct {
+	music   *audio.Player
+	effects map[string][]byte
+	volume  float64
+	muted   bool
+}
+
+func NewSoundManager() *SoundManager {
+	return &SoundManager{
+		effects: map[string][]byte{
+			"catch":     mustDecodeWAV("assets/audio/catch.wav"),
+			"bomb":      mustDecodeWAV("assets/audio/bomb.wav"),
+			"game-over": mustDecodeWAV("assets/audio/game-over.wav"),
+		},
+		volume: 1,
+	}
+}
+
+func mustDecodeWAV(name string) []byte {
+	f, err := assets.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+
+	stream, err := wav.DecodeWithoutResampling(bytes.NewReader(f))
+	if err != nil {
+		panic(err)
+	}
+
+	b, err := io.ReadAll(stream)
+	if err != nil {
+		panic(err)
+	}
+
+	return b
+}
+
+func (s *SoundManager) PlayMusic(name string) {
+	if s.music != nil {
+		s.music.Close()
+		s.music = nil
+	}
+
+	f, err := assets.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+
+	stream, err := vorbis.DecodeWithoutResampling(bytes.NewReader(f))
+	if err != nil {
+		panic(err)
+	}
+
+	loop := audio.NewInfiniteLoop(stream, stream.Length())
+
+	player, err := audio.NewPlayer(audioContext, loop)
+	if err != nil {
+		panic(err)
+	}
+
+	player.SetVolume(s.volume)
+	if !s.muted {
+		player.Play()
+	}
+
+	s.music = player
+}
+
+func (s *SoundManager) StopMusic() {
+	if s.music != nil {
+		s.music.Pause()
+	}
+}
+
+func (s *SoundManager) Play(name string) {
+	if s.muted {
+		return
+	}
+
+	data, ok := s.effects[name]
+	if !ok {
+		return
+	}
+
+	player := audio.NewPlayerFromBytes(audioContext, data)
+	player.SetVolume(s.volume)
+	player.Play()
+}
+
+func (s *SoundManager) ToggleMute() {
+	s.muted = !s.muted
+	if s.music == nil {
+		return
+	}
+
+	if s.muted {
+		s.music.Pause()
+	} else {
+		s.music.Play()
+	}
+}
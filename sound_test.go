@@ -0,0 +1,36 @@
+package main
+
+import "testing"
+
+func TestNewSoundManagerLoadsEffects(t *testing.T) {
+	s := NewSoundManager()
+	for _, name := range []string{"catch", "bomb", "game-over"} {
+		if len(s.effects[name]) == 0 {
+			t.Fatalf("effects[%q] is empty", name)
+		}
+	}
+}
+
+func TestToggleMuteFlipsMutedWithNoMusicPlaying(t *testing.T) {
+	s := &SoundManager{}
+
+	s.ToggleMute()
+	if !s.muted {
+		t.Fatalf("muted = false after ToggleMute, want true")
+	}
+
+	s.ToggleMute()
+	if s.muted {
+		t.Fatalf("muted = true after second ToggleMute, want false")
+	}
+}
+
+func TestPlayMutedIsNoOp(t *testing.T) {
+	s := &SoundManager{muted: true, effects: map[string][]byte{"catch": {1, 2, 3}}}
+	s.Play("catch")
+}
+
+func TestPlayUnknownEffectIsNoOp(t *testing.T) {
+	s := &SoundManager{effects: map[string][]byte{"catch": {1, 2, 3}}}
+	s.Play("does-not-exist")
+}
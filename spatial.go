@@ -0,0 +1,60 @@
+package main
+
+// gridCellSize is chosen to roughly match a food sprite's width, so most
+// items only ever occupy one or two cells.
+const gridCellSize = 48.0
+
+type gridKey struct {
+	X, Y int
+}
+
+type Grid struct {
+	cellSize float64
+	cells    map[gridKey][]*Food
+}
+
+func NewGrid(cellSize float64) *Grid {
+	return &Grid{
+		cellSize: cellSize,
+		cells:    make(map[gridKey][]*Food),
+	}
+}
+
+func (g *Grid) Clear() {
+	for k := range g.cells {
+		delete(g.cells, k)
+	}
+}
+
+func (g *Grid) key(x, y float64) gridKey {
+	return gridKey{int(x / g.cellSize), int(y / g.cellSize)}
+}
+
+func (g *Grid) Insert(f *Food) {
+	r := f.Collider()
+	min := g.key(r.X, r.Y)
+	max := g.key(r.MaxX(), r.MaxY())
+
+	for x := min.X; x <= max.X; x++ {
+		for y := min.Y; y <= max.Y; y++ {
+			k := gridKey{x, y}
+			g.cells[k] = append(g.cells[k], f)
+		}
+	}
+}
+
+// Query returns the food registered in any cell overlapping r. Items
+// spanning multiple cells may appear more than once; callers that mutate
+// state per item should dedupe.
+func (g *Grid) Query(r Rect) []*Food {
+	min := g.key(r.X, r.Y)
+	max := g.key(r.MaxX(), r.MaxY())
+
+	var out []*Food
+	for x := min.X; x <= max.X; x++ {
+		for y := min.Y; y <= max.Y; y++ {
+			out = append(out, g.cells[gridKey{x, y}]...)
+		}
+	}
+	return out
+}
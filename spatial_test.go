@@ -0,0 +1,76 @@
+package main
+
+import "testing"
+
+func TestGridQueryFindsOverlappingItem(t *testing.T) {
+	grid := NewGrid(gridCellSize)
+	f := newFood(FoodGood, baseGravity)
+	f.Position = Vector{X: 100, Y: 100}
+	grid.Insert(f)
+
+	found := false
+	for _, got := range grid.Query(NewRect(90, 90, 40, 40)) {
+		if got == f {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("Query did not return an item inserted in an overlapping cell")
+	}
+}
+
+func TestGridQueryExcludesDistantItem(t *testing.T) {
+	grid := NewGrid(gridCellSize)
+	f := newFood(FoodGood, baseGravity)
+	f.Position = Vector{X: 500, Y: 400}
+	grid.Insert(f)
+
+	for _, got := range grid.Query(NewRect(0, 0, 20, 20)) {
+		if got == f {
+			t.Fatalf("Query returned an item far outside the query rect")
+		}
+	}
+}
+
+func TestGridClearEmptiesGrid(t *testing.T) {
+	grid := NewGrid(gridCellSize)
+	f := newFood(FoodGood, baseGravity)
+	f.Position = Vector{X: 100, Y: 100}
+	grid.Insert(f)
+
+	grid.Clear()
+
+	if got := grid.Query(NewRect(90, 90, 40, 40)); len(got) != 0 {
+		t.Fatalf("Query after Clear returned %d items, want 0", len(got))
+	}
+}
+
+func BenchmarkGridQuery(b *testing.B) {
+	grid := NewGrid(gridCellSize)
+	for i := 0; i < 10000; i++ {
+		f := newFood(FoodGood, baseGravity)
+		f.Position = Vector{X: float64(i % screenWidth), Y: float64(i)}
+		grid.Insert(f)
+	}
+
+	playerRect := NewRect(300, 420, 64, 64)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		grid.Query(playerRect)
+	}
+}
+
+// BenchmarkGameUpdate exercises a full Update tick with 10k falling items,
+// the scale at which the prior O(N) scan-and-splice approach broke down.
+func BenchmarkGameUpdate(b *testing.B) {
+	g := initGame()
+	for i := 0; i < 10000; i++ {
+		g.food = append(g.food, newFood(FoodGood, baseGravity))
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		g.Update()
+	}
+}